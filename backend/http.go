@@ -0,0 +1,109 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package backend
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// HTTPStore is a ShardStore that keeps shards on a remote HTTP(S)
+// server. Shard idx is addressed as "<BaseURL>/shard/<idx>"; PUT
+// writes it, GET reads it, HEAD stats it and DELETE removes it, which
+// matches a plain static file server or object-store gateway placed
+// behind that path.
+type HTTPStore struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewHTTPStore returns an HTTPStore talking to baseURL. If client is
+// nil, http.DefaultClient is used.
+func NewHTTPStore(baseURL string, client *http.Client) *HTTPStore {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPStore{BaseURL: baseURL, Client: client}
+}
+
+func (s *HTTPStore) url(idx int) string {
+	return fmt.Sprintf("%s/shard/%d", s.BaseURL, idx)
+}
+
+func (s *HTTPStore) PutShard(idx int, r io.Reader) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPut, s.url(idx), bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(b))
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("backend: PUT %s: unexpected status %s", s.url(idx), resp.Status)
+	}
+	return nil
+}
+
+func (s *HTTPStore) GetShard(idx int) (io.ReadCloser, error) {
+	resp, err := s.Client.Get(s.url(idx))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrNotExist
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("backend: GET %s: unexpected status %s", s.url(idx), resp.Status)
+	}
+	return resp.Body, nil
+}
+
+func (s *HTTPStore) StatShard(idx int) (ShardInfo, error) {
+	resp, err := s.Client.Head(s.url(idx))
+	if err != nil {
+		return ShardInfo{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return ShardInfo{}, ErrNotExist
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ShardInfo{}, fmt.Errorf("backend: HEAD %s: unexpected status %s", s.url(idx), resp.Status)
+	}
+	size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return ShardInfo{}, fmt.Errorf("backend: HEAD %s: %w", s.url(idx), err)
+	}
+	return ShardInfo{Index: idx, Size: size}, nil
+}
+
+func (s *HTTPStore) DeleteShard(idx int) error {
+	req, err := http.NewRequest(http.MethodDelete, s.url(idx), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("backend: DELETE %s: unexpected status %s", s.url(idx), resp.Status)
+	}
+	return nil
+}
+
+var _ ShardStore = (*HTTPStore)(nil)
@@ -0,0 +1,79 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package backend
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore is a ShardStore backed by a single directory on the local
+// filesystem, one file per shard index named "shard.<idx>".
+type LocalStore struct {
+	Dir string
+}
+
+// NewLocalStore returns a LocalStore rooted at dir, creating it if it
+// does not already exist.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{Dir: dir}, nil
+}
+
+func (s *LocalStore) path(idx int) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("shard.%d", idx))
+}
+
+func (s *LocalStore) PutShard(idx int, r io.Reader) error {
+	tmp := s.path(idx) + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, s.path(idx))
+}
+
+func (s *LocalStore) GetShard(idx int) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(idx))
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *LocalStore) StatShard(idx int) (ShardInfo, error) {
+	fi, err := os.Stat(s.path(idx))
+	if os.IsNotExist(err) {
+		return ShardInfo{}, ErrNotExist
+	}
+	if err != nil {
+		return ShardInfo{}, err
+	}
+	return ShardInfo{Index: idx, Size: fi.Size()}, nil
+}
+
+func (s *LocalStore) DeleteShard(idx int) error {
+	err := os.Remove(s.path(idx))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+var _ ShardStore = (*LocalStore)(nil)
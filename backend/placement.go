@@ -0,0 +1,169 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package backend
+
+import "fmt"
+
+// Domain tags a ShardStore with the failure domain it lives in, so a
+// Placement can spread a stripe's shards across domains instead of
+// piling them onto one rack or zone.
+type Domain struct {
+	Zone string
+	Rack string
+}
+
+// Node pairs a ShardStore with the Domain it lives in.
+type Node struct {
+	Store  ShardStore
+	Domain Domain
+	// Weight biases WeightedPlacement towards this node; stores with a
+	// higher weight receive proportionally more shards. Ignored by
+	// RoundRobinPlacement. Zero defaults to 1.
+	Weight int
+}
+
+// Placement decides, for one stripe, which Node each shard index is
+// written to. Plan returns len(shards)-length slice where element i is
+// the node index (into the Nodes passed to NewPlacement) for shard i.
+type Placement interface {
+	Plan(stripe int, numShards int) ([]int, error)
+}
+
+// RoundRobinPlacement assigns shard i of stripe s to node (i+s) mod
+// len(Nodes), so repeated stripes rotate which node holds which shard
+// instead of always favoring node 0.
+type RoundRobinPlacement struct {
+	Nodes []Node
+}
+
+func (p *RoundRobinPlacement) Plan(stripe int, numShards int) ([]int, error) {
+	if len(p.Nodes) < numShards {
+		return nil, fmt.Errorf("backend: round-robin placement: %d nodes, need at least %d", len(p.Nodes), numShards)
+	}
+	plan := make([]int, numShards)
+	for i := range plan {
+		plan[i] = (i + stripe) % len(p.Nodes)
+	}
+	return plan, nil
+}
+
+// WeightedPlacement assigns shards to nodes in proportion to Node.Weight,
+// rotating per stripe like RoundRobinPlacement so the same node isn't
+// always favored for shard 0. It is zone/rack agnostic; combine it with
+// FailureDomainPlacement for rack/zone-aware distribution.
+type WeightedPlacement struct {
+	Nodes []Node
+}
+
+func (p *WeightedPlacement) Plan(stripe int, numShards int) ([]int, error) {
+	if len(p.Nodes) < numShards {
+		return nil, fmt.Errorf("backend: weighted placement: %d nodes, need at least %d", len(p.Nodes), numShards)
+	}
+	order := p.weightedOrder()
+	used := make([]bool, len(p.Nodes))
+	plan := make([]int, 0, numShards)
+	// Walk the weighted order starting at a per-stripe offset, skipping
+	// nodes already used by this stripe's plan. A heavier-weighted node
+	// still lands first more often across stripes, but never twice in
+	// the same stripe -- order contains every node index at least once,
+	// so this always terminates once len(p.Nodes) >= numShards.
+	for offset := 0; len(plan) < numShards; offset++ {
+		idx := order[(offset+stripe)%len(order)]
+		if used[idx] {
+			continue
+		}
+		used[idx] = true
+		plan = append(plan, idx)
+	}
+	return plan, nil
+}
+
+// weightedOrder expands p.Nodes into a slice where node index i appears
+// Weight(i) times, so picking round-robin through it approximates
+// weighted distribution.
+func (p *WeightedPlacement) weightedOrder() []int {
+	var order []int
+	for i, n := range p.Nodes {
+		w := n.Weight
+		if w <= 0 {
+			w = 1
+		}
+		for j := 0; j < w; j++ {
+			order = append(order, i)
+		}
+	}
+	return order
+}
+
+// FailureDomainPlacement wraps another Placement and rejects (or, in
+// Best-effort mode, merely reorders) plans that would put two shards of
+// the same stripe in the same zone or rack, so a single rack/zone
+// outage cannot take out more shards than the code can tolerate.
+type FailureDomainPlacement struct {
+	Nodes []Node
+	// Inner computes the base plan which FailureDomainPlacement then
+	// checks/adjusts for domain collisions.
+	Inner Placement
+	// BestEffort, when true, falls back to the first domain-collision
+	// it can't avoid instead of returning an error. When false (the
+	// default), Plan fails if no collision-free arrangement exists.
+	BestEffort bool
+}
+
+func (p *FailureDomainPlacement) Plan(stripe int, numShards int) ([]int, error) {
+	base, err := p.Inner.Plan(stripe, numShards)
+	if err != nil {
+		return nil, err
+	}
+
+	usedZone := map[string]bool{}
+	usedRack := map[string]bool{}
+	plan := make([]int, numShards)
+	copy(plan, base)
+
+	for i, nodeIdx := range plan {
+		d := p.Nodes[nodeIdx].Domain
+		if !usedZone[d.Zone] && !usedRack[d.Rack] {
+			usedZone[d.Zone] = true
+			usedRack[d.Rack] = true
+			continue
+		}
+		alt, ok := p.findFreeDomain(plan[:i], usedZone, usedRack)
+		if !ok {
+			if p.BestEffort {
+				continue
+			}
+			return nil, fmt.Errorf("backend: failure-domain placement: no collision-free node for shard %d of stripe %d", i, stripe)
+		}
+		plan[i] = alt
+		d = p.Nodes[alt].Domain
+		usedZone[d.Zone] = true
+		usedRack[d.Rack] = true
+	}
+	return plan, nil
+}
+
+// findFreeDomain returns a node index not already used in used/picked
+// whose zone and rack are both unused so far in this stripe.
+func (p *FailureDomainPlacement) findFreeDomain(picked []int, usedZone, usedRack map[string]bool) (int, bool) {
+	taken := map[int]bool{}
+	for _, idx := range picked {
+		taken[idx] = true
+	}
+	for i, n := range p.Nodes {
+		if taken[i] {
+			continue
+		}
+		if usedZone[n.Domain.Zone] || usedRack[n.Domain.Rack] {
+			continue
+		}
+		return i, true
+	}
+	return 0, false
+}
+
+var (
+	_ Placement = (*RoundRobinPlacement)(nil)
+	_ Placement = (*WeightedPlacement)(nil)
+	_ Placement = (*FailureDomainPlacement)(nil)
+)
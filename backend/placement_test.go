@@ -0,0 +1,54 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package backend
+
+import "testing"
+
+func distinctNodes(t *testing.T, plan []int) {
+	t.Helper()
+	seen := make(map[int]bool, len(plan))
+	for _, idx := range plan {
+		if seen[idx] {
+			t.Fatalf("plan %v assigns node %d more than once in the same stripe", plan, idx)
+		}
+		seen[idx] = true
+	}
+}
+
+func TestWeightedPlacementNoCollision(t *testing.T) {
+	p := &WeightedPlacement{
+		Nodes: []Node{
+			{Weight: 3},
+			{Weight: 1},
+			{Weight: 1},
+		},
+	}
+	for stripe := 0; stripe < 10; stripe++ {
+		plan, err := p.Plan(stripe, 3)
+		if err != nil {
+			t.Fatalf("stripe %d: Plan: %v", stripe, err)
+		}
+		if len(plan) != 3 {
+			t.Fatalf("stripe %d: got %d entries, want 3", stripe, len(plan))
+		}
+		distinctNodes(t, plan)
+	}
+}
+
+func TestWeightedPlacementNotEnoughNodes(t *testing.T) {
+	p := &WeightedPlacement{Nodes: []Node{{Weight: 5}, {Weight: 1}}}
+	if _, err := p.Plan(0, 3); err == nil {
+		t.Fatal("expected an error when numShards exceeds the node count")
+	}
+}
+
+func TestRoundRobinPlacementNoCollision(t *testing.T) {
+	p := &RoundRobinPlacement{Nodes: make([]Node, 4)}
+	for stripe := 0; stripe < 8; stripe++ {
+		plan, err := p.Plan(stripe, 4)
+		if err != nil {
+			t.Fatalf("stripe %d: Plan: %v", stripe, err)
+		}
+		distinctNodes(t, plan)
+	}
+}
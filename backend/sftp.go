@@ -0,0 +1,91 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package backend
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPStore is a ShardStore that keeps shards in a single directory on
+// a remote host reachable over SFTP. It wraps an already-authenticated
+// *sftp.Client so callers control the underlying ssh.Client/dialing,
+// auth method and host key checking themselves.
+type SFTPStore struct {
+	Client *sftp.Client
+	Dir    string
+}
+
+// NewSFTPStore returns an SFTPStore rooted at dir on the other end of
+// conn, creating dir if it does not already exist. The caller owns
+// conn and must close it (and the returned store's Client) once done.
+func NewSFTPStore(conn *ssh.Client, dir string) (*SFTPStore, error) {
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.MkdirAll(dir); err != nil {
+		client.Close()
+		return nil, err
+	}
+	return &SFTPStore{Client: client, Dir: dir}, nil
+}
+
+func (s *SFTPStore) path(idx int) string {
+	return path.Join(s.Dir, fmt.Sprintf("shard.%d", idx))
+}
+
+func (s *SFTPStore) PutShard(idx int, r io.Reader) error {
+	tmp := s.path(idx) + ".tmp"
+	f, err := s.Client.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		s.Client.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		s.Client.Remove(tmp)
+		return err
+	}
+	return s.Client.Rename(tmp, s.path(idx))
+}
+
+func (s *SFTPStore) GetShard(idx int) (io.ReadCloser, error) {
+	f, err := s.Client.Open(s.path(idx))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotExist
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *SFTPStore) StatShard(idx int) (ShardInfo, error) {
+	fi, err := s.Client.Stat(s.path(idx))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ShardInfo{}, ErrNotExist
+		}
+		return ShardInfo{}, err
+	}
+	return ShardInfo{Index: idx, Size: fi.Size()}, nil
+}
+
+func (s *SFTPStore) DeleteShard(idx int) error {
+	err := s.Client.Remove(s.path(idx))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+var _ ShardStore = (*SFTPStore)(nil)
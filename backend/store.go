@@ -0,0 +1,43 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+// Package backend lets shards produced by the reedsolomon package live
+// on different nodes, racks or zones instead of plain local files. A
+// ShardStore abstracts "put/get/stat/delete a shard by index" over a
+// particular transport, and a Placement decides which store each shard
+// index of a stripe is written to.
+package backend
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrNotExist is returned by GetShard/StatShard when the requested
+// shard has not been written (or was deleted).
+var ErrNotExist = errors.New("backend: shard does not exist")
+
+// ShardInfo describes a stored shard as reported by StatShard.
+type ShardInfo struct {
+	Index int
+	Size  int64
+}
+
+// ShardStore persists individual erasure-coded shards, keyed by their
+// logical index within a stripe. Implementations must be safe for
+// concurrent use across different indices; concurrent calls for the
+// same index are not required to be safe.
+type ShardStore interface {
+	// PutShard writes the full contents of r as shard idx, replacing
+	// any previous contents.
+	PutShard(idx int, r io.Reader) error
+	// GetShard opens shard idx for reading. The caller must Close it.
+	// It returns ErrNotExist if the shard has not been written.
+	GetShard(idx int) (io.ReadCloser, error)
+	// StatShard reports metadata about shard idx without reading its
+	// contents. It returns ErrNotExist if the shard has not been
+	// written.
+	StatShard(idx int) (ShardInfo, error)
+	// DeleteShard removes shard idx. It is not an error to delete a
+	// shard that does not exist.
+	DeleteShard(idx int) error
+}
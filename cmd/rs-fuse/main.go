@@ -0,0 +1,218 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+// Command rs-fuse mounts a ShardSet manifest as a read-only FUSE
+// filesystem, exposing the original encoded file as a single regular
+// file. Reads lazily fetch only the stripes overlapping the requested
+// offset/length, reconstructing on the fly via the manifest's
+// placement when a shard is missing or corrupt, and schedule a
+// background heal for any shards that reconstruction had to stand in
+// for.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+
+	"github.com/DurantVivado/reedsolomon"
+)
+
+var manifestPath = flag.String("manifest", "manifest.json", "Path to the ShardSet manifest")
+var fileName = flag.String("name", "data", "Name the decoded file appears under inside the mount")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  rs-fuse [-manifest manifest.json] [-name data] <mountpoint>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	mountpoint := args[0]
+
+	set, err := reedsolomon.OpenShardSet(*manifestPath)
+	checkErr(err)
+	enc, err := reedsolomon.New(set.Manifest.DataShards, set.Manifest.ParShards)
+	checkErr(err)
+
+	present, err := set.Verify()
+	checkErr(err)
+
+	srv := &server{
+		set:     set,
+		enc:     enc,
+		name:    *fileName,
+		present: present,
+	}
+
+	c, err := fuse.Mount(mountpoint, fuse.ReadOnly(), fuse.FSName("rs-fuse"), fuse.Subtype("reedsolomon"))
+	checkErr(err)
+	defer c.Close()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	go func() {
+		<-sigCh
+		fuse.Unmount(mountpoint)
+	}()
+
+	checkErr(fs.Serve(c, srv))
+}
+
+// server implements fs.FS over a single ShardSet.
+type server struct {
+	set  *reedsolomon.ShardSet
+	enc  reedsolomon.Encoder
+	name string
+
+	mu      sync.Mutex
+	present []bool
+	healing bool
+}
+
+func (s *server) Root() (fs.Node, error) {
+	return &dir{s: s}, nil
+}
+
+// dir is the mount's read-only root directory, containing the single
+// decoded file.
+type dir struct {
+	s *server
+}
+
+func (d *dir) Attr(ctx context.Context, a *fuse.Attr) error {
+	a.Mode = os.ModeDir | 0o555
+	return nil
+}
+
+func (d *dir) Lookup(ctx context.Context, name string) (fs.Node, error) {
+	if name != d.s.name {
+		return nil, fuse.ENOENT
+	}
+	return &file{s: d.s}, nil
+}
+
+func (d *dir) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	return []fuse.Dirent{
+		{Name: d.s.name, Type: fuse.DT_File},
+	}, nil
+}
+
+// file is the read-only view of the decoded original file.
+type file struct {
+	s *server
+}
+
+func (f *file) Attr(ctx context.Context, a *fuse.Attr) error {
+	a.Mode = 0o444
+	a.Size = uint64(f.s.set.Manifest.FileSize)
+	return nil
+}
+
+// Read serves req.Size bytes starting at req.Offset, fetching and
+// reconstructing only the stripes that overlap the requested range.
+func (f *file) Read(ctx context.Context, req *fuse.ReadRequest, resp *fuse.ReadResponse) error {
+	m := f.s.set.Manifest
+	stripeSize := int64(m.DataShards) * m.BlockSize
+
+	start := req.Offset
+	end := start + int64(req.Size)
+	if end > m.FileSize {
+		end = m.FileSize
+	}
+	if start >= end {
+		return nil
+	}
+
+	out := make([]byte, 0, end-start)
+	for off := start; off < end; {
+		stripeIdx := int(off / stripeSize)
+		stripeStart := int64(stripeIdx) * stripeSize
+		stripe, err := f.s.readStripe(stripeIdx)
+		if err != nil {
+			return err
+		}
+		within := off - stripeStart
+		avail := int64(len(stripe)) - within
+		if avail <= 0 {
+			break
+		}
+		want := end - off
+		if want > avail {
+			want = avail
+		}
+		out = append(out, stripe[within:within+want]...)
+		off += want
+	}
+	resp.Data = out
+	return nil
+}
+
+// readStripe reconstructs stripe idx using whichever shards are still
+// present, scheduling a background heal if any were missing/corrupt.
+func (f *server) readStripe(idx int) ([]byte, error) {
+	f.mu.Lock()
+	present := f.present
+	f.mu.Unlock()
+
+	stripe, err := f.set.ReadStripe(f.enc, idx, present)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ok := range present {
+		if !ok {
+			f.scheduleHeal()
+			break
+		}
+	}
+	return stripe, nil
+}
+
+// scheduleHeal kicks off a background Healer.Heal pass at most once at
+// a time, so repeated reads of a degraded file don't pile up repairs.
+func (f *server) scheduleHeal() {
+	f.mu.Lock()
+	if f.healing {
+		f.mu.Unlock()
+		return
+	}
+	f.healing = true
+	f.mu.Unlock()
+
+	go func() {
+		healer := reedsolomon.NewHealer(f.set, f.enc)
+		report, err := healer.Heal()
+		f.mu.Lock()
+		f.healing = false
+		if err == nil {
+			present, verr := f.set.Verify()
+			if verr == nil {
+				f.present = present
+			}
+		}
+		f.mu.Unlock()
+		if err != nil {
+			log.Printf("rs-fuse: background heal failed: %v", err)
+			return
+		}
+		log.Printf("rs-fuse: background heal repaired shards %v", report.Repaired)
+	}()
+}
+
+func checkErr(err error) {
+	if err != nil {
+		log.Fatalf("rs-fuse: %v", err)
+	}
+}
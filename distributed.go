@@ -0,0 +1,192 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/DurantVivado/reedsolomon/backend"
+)
+
+// DistributedOptions configures NewDistributedWriter/NewDistributedReader.
+type DistributedOptions struct {
+	DataShards int
+	ParShards  int
+	// BlockSize is the per-shard size of one stripe. Defaults to 1<<20
+	// (1MiB) when zero.
+	BlockSize int64
+}
+
+func (o *DistributedOptions) setDefaults() {
+	if o.BlockSize <= 0 {
+		o.BlockSize = 1 << 20
+	}
+}
+
+// DistributedWriter is the distributed-erasure counterpart of
+// ShardWriter: instead of writing N+M files into one directory, it
+// hands each stripe's shards to a backend.Placement, which assigns
+// every shard index to one of Stores, so shards end up spread across
+// whatever nodes/racks/zones those backend.ShardStore values represent.
+type DistributedWriter struct {
+	enc       Encoder
+	stores    []backend.ShardStore
+	placement backend.Placement
+	opts      DistributedOptions
+}
+
+// NewDistributedWriter returns a DistributedWriter that encodes
+// through enc and places shards using placement. len(stores) must be
+// at least opts.DataShards+opts.ParShards; placement is free to use
+// only a subset of them per stripe.
+func NewDistributedWriter(enc Encoder, stores []backend.ShardStore, placement backend.Placement, opts DistributedOptions) (*DistributedWriter, error) {
+	if enc == nil {
+		return nil, fmt.Errorf("reedsolomon: NewDistributedWriter: nil Encoder")
+	}
+	total := opts.DataShards + opts.ParShards
+	if opts.DataShards <= 0 || opts.ParShards < 0 || len(stores) < total {
+		return nil, fmt.Errorf("reedsolomon: NewDistributedWriter: need at least %d stores, got %d", total, len(stores))
+	}
+	opts.setDefaults()
+	return &DistributedWriter{enc: enc, stores: stores, placement: placement, opts: opts}, nil
+}
+
+// DistributedLayout records, for every stripe written, which store
+// index each logical shard was sent to: Placement[stripe][shardIdx].
+// The caller is responsible for persisting it (e.g. as JSON next to a
+// Manifest) so a DistributedReader can later be told where to look.
+type DistributedLayout struct {
+	FileSize  int64
+	Placement [][]int
+}
+
+// shardKey folds a stripe and logical shard index into the single int
+// key a ShardStore addresses shards by. ShardStore has no notion of
+// stripes -- PutShard(idx, ...) fully replaces whatever idx previously
+// held -- so without this, a placement that reuses the same (store,
+// shardIdx) pair across stripes (the normal case once a file has more
+// stripes than stores) would silently overwrite earlier stripes' data
+// with later ones.
+func shardKey(stripe, shardIdx, total int) int {
+	return stripe*total + shardIdx
+}
+
+// WriteFile reads all of src, splitting and encoding it stripe by
+// stripe like ShardWriter.WriteFile, but sending each shard to the
+// backend.ShardStore chosen by w.placement instead of a local file.
+func (w *DistributedWriter) WriteFile(src io.Reader, fileSize int64) (*DistributedLayout, error) {
+	total := w.opts.DataShards + w.opts.ParShards
+	stripeSize := int64(w.opts.DataShards) * w.opts.BlockSize
+
+	layout := &DistributedLayout{FileSize: fileSize}
+	buf := make([]byte, stripeSize)
+
+	for stripe := 0; ; stripe++ {
+		n, err := io.ReadFull(src, buf)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		shards, _, encErr := splitEncodeStripe(w.enc, buf, n)
+		if encErr != nil {
+			return nil, encErr
+		}
+
+		plan, err := w.placement.Plan(stripe, total)
+		if err != nil {
+			return nil, err
+		}
+		for shardIdx, storeIdx := range plan {
+			key := shardKey(stripe, shardIdx, total)
+			if err := w.stores[storeIdx].PutShard(key, bytes.NewReader(shards[shardIdx])); err != nil {
+				return nil, fmt.Errorf("reedsolomon: writing stripe %d shard %d to store %d: %w", stripe, shardIdx, storeIdx, err)
+			}
+		}
+		layout.Placement = append(layout.Placement, plan)
+
+		if err == io.EOF || int64(n) < stripeSize {
+			break
+		}
+	}
+	return layout, nil
+}
+
+// DistributedReader decodes a file previously written by
+// DistributedWriter, pulling each stripe's shards from whichever
+// stores are still reachable and reconstructing the rest, so the
+// healer only needs to read from surviving nodes.
+type DistributedReader struct {
+	enc    Encoder
+	stores []backend.ShardStore
+	opts   DistributedOptions
+	layout *DistributedLayout
+}
+
+// NewDistributedReader returns a DistributedReader for the file
+// described by layout, which must have come from the DistributedWriter
+// that originally wrote it (same stores, in the same order).
+func NewDistributedReader(enc Encoder, stores []backend.ShardStore, opts DistributedOptions, layout *DistributedLayout) *DistributedReader {
+	opts.setDefaults()
+	return &DistributedReader{enc: enc, stores: stores, opts: opts, layout: layout}
+}
+
+// Decode reconstructs and joins the file into dst.
+func (r *DistributedReader) Decode(dst io.Writer) error {
+	total := r.opts.DataShards + r.opts.ParShards
+	for stripe, plan := range r.layout.Placement {
+		shards := make([][]byte, len(plan))
+		good := 0
+		for shardIdx, storeIdx := range plan {
+			key := shardKey(stripe, shardIdx, total)
+			rc, err := r.stores[storeIdx].GetShard(key)
+			if err == backend.ErrNotExist {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("reedsolomon: reading stripe %d shard %d from store %d: %w", stripe, shardIdx, storeIdx, err)
+			}
+			b, err := io.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+			shards[shardIdx] = b
+			good++
+		}
+		if good < r.opts.DataShards {
+			return fmt.Errorf("reedsolomon: stripe %d: only %d of %d shards reachable, need %d", stripe, good, len(plan), r.opts.DataShards)
+		}
+		if err := r.enc.ReconstructData(shards); err != nil {
+			return err
+		}
+		outSize := -1
+		if stripe == len(r.layout.Placement)-1 {
+			outSize = int(r.layout.FileSize - int64(stripe)*int64(r.opts.DataShards)*r.opts.BlockSize)
+		}
+		if outSize < 0 {
+			if err := joinShards(dst, shards, r.opts.DataShards); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.enc.Join(dst, shards, outSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// joinShards writes the full (unpadded) data shards of a non-final
+// stripe straight to dst, since only the last stripe can be short.
+func joinShards(dst io.Writer, shards [][]byte, dataShards int) error {
+	for i := 0; i < dataShards; i++ {
+		if _, err := dst.Write(shards[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/DurantVivado/reedsolomon/backend"
+)
+
+// TestDistributedWriterReaderRoundTrip writes a file long enough to span
+// more stripes than there are stores, so RoundRobinPlacement is forced
+// to reuse the same (store, shardIdx) pair across stripes. Without
+// shardKey folding the stripe into the store key, later stripes would
+// silently clobber earlier ones and Decode would return wrong bytes.
+func TestDistributedWriterReaderRoundTrip(t *testing.T) {
+	enc, err := New(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const numStores = 6 // == DataShards+ParShards, so every stripe uses all stores
+	stores := make([]backend.ShardStore, numStores)
+	nodes := make([]backend.Node, numStores)
+	for i := range stores {
+		s, err := backend.NewLocalStore(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+		stores[i] = s
+		nodes[i] = backend.Node{Store: s}
+	}
+	placement := &backend.RoundRobinPlacement{Nodes: nodes}
+
+	opts := DistributedOptions{DataShards: 4, ParShards: 2, BlockSize: 256}
+	w, err := NewDistributedWriter(enc, stores, placement, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 11 stripes' worth of data: enough stripes that shardIdx/store
+	// pairs repeat (RoundRobin cycles every numStores stripes).
+	want := make([]byte, 11*int(opts.DataShards)*int(opts.BlockSize)+123)
+	for i := range want {
+		want[i] = byte(i * 13)
+	}
+
+	layout, err := w.WriteFile(bytes.NewReader(want), int64(len(want)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layout.Placement) < numStores+1 {
+		t.Fatalf("test needs more stripes than stores to exercise reuse, got %d stripes for %d stores", len(layout.Placement), numStores)
+	}
+
+	r := NewDistributedReader(enc, stores, opts, layout)
+	var got bytes.Buffer
+	if err := r.Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Fatalf("decoded %d bytes, want %d bytes matching the original", got.Len(), len(want))
+	}
+}
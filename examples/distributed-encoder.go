@@ -0,0 +1,90 @@
+//go:build ignore
+// +build ignore
+
+// Copyright 2026, DurantVivado, see LICENSE for details.
+//
+// Distributed encoder example
+//
+// Like simple-encoder, but instead of writing N+M files into one
+// directory, each shard is sent to its own local directory, standing
+// in for N+M separate nodes/racks/zones. See reedsolomon/backend for
+// the ShardStore and Placement types this relies on, and
+// reedsolomon/distributed.go for DistributedWriter.
+//
+// To build an executable use:
+//
+// go build distributed-encoder.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DurantVivado/reedsolomon"
+	"github.com/DurantVivado/reedsolomon/backend"
+)
+
+var dataShards = flag.Int("data", 4, "Number of shards to split the data into, must be below 257.")
+var parShards = flag.Int("par", 2, "Number of parity shards")
+var blockSize = flag.Int64("bs", 1024, "block size")
+var outDir = flag.String("out", ".", "Directory to create one subdirectory per simulated node in")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  distributed-encoder [-flags] filename.ext\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "Error: No input filename given\n")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	enc, err := reedsolomon.New(*dataShards, *parShards)
+	checkErr(err)
+
+	total := *dataShards + *parShards
+	nodes := make([]backend.Node, total)
+	stores := make([]backend.ShardStore, total)
+	for i := 0; i < total; i++ {
+		dir := fmt.Sprintf("%s/node%d", *outDir, i)
+		store, err := backend.NewLocalStore(dir)
+		checkErr(err)
+		stores[i] = store
+		nodes[i] = backend.Node{Store: store, Domain: backend.Domain{Zone: fmt.Sprintf("zone%d", i%2), Rack: fmt.Sprintf("rack%d", i)}}
+	}
+	placement := &backend.FailureDomainPlacement{
+		Nodes: nodes,
+		Inner: &backend.RoundRobinPlacement{Nodes: nodes},
+	}
+
+	w, err := reedsolomon.NewDistributedWriter(enc, stores, placement, reedsolomon.DistributedOptions{
+		DataShards: *dataShards,
+		ParShards:  *parShards,
+		BlockSize:  *blockSize,
+	})
+	checkErr(err)
+
+	f, err := os.Open(args[0])
+	checkErr(err)
+	defer f.Close()
+	fi, err := f.Stat()
+	checkErr(err)
+
+	layout, err := w.WriteFile(f, fi.Size())
+	checkErr(err)
+
+	fmt.Printf("wrote %d stripes across %d nodes\n", len(layout.Placement), total)
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,57 @@
+//go:build ignore
+// +build ignore
+
+// Copyright 2026, DurantVivado, see LICENSE for details.
+//
+// Repair example
+//
+// Repair walks a ShardSet written by simple-encoder, reconstructs any
+// missing or corrupt shards -- data and parity alike -- from the
+// surviving shards, and rewrites them in place.
+//
+// To build an executable use:
+//
+// go build repair.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DurantVivado/reedsolomon"
+)
+
+var manifestPath = flag.String("manifest", "manifest.json", "Path to the ShardSet manifest")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  repair [-manifest manifest.json]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	set, err := reedsolomon.OpenShardSet(*manifestPath)
+	checkErr(err)
+
+	enc, err := reedsolomon.New(set.Manifest.DataShards, set.Manifest.ParShards)
+	checkErr(err)
+
+	healer := reedsolomon.NewHealer(set, enc)
+	report, err := healer.Heal()
+	checkErr(err)
+
+	fmt.Printf("repaired: %v\n", report.Repaired)
+	if len(report.Repaired) == 0 {
+		fmt.Println("nothing to repair")
+	}
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,58 @@
+//go:build ignore
+// +build ignore
+
+// Copyright 2026, DurantVivado, see LICENSE for details.
+//
+// Scrub example
+//
+// Scrub walks a ShardSet written by simple-encoder and reports which
+// shards are missing or corrupt, without repairing anything.
+//
+// To build an executable use:
+//
+// go build scrub.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DurantVivado/reedsolomon"
+)
+
+var manifestPath = flag.String("manifest", "manifest.json", "Path to the ShardSet manifest")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  scrub [-manifest manifest.json]\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	set, err := reedsolomon.OpenShardSet(*manifestPath)
+	checkErr(err)
+
+	enc, err := reedsolomon.New(set.Manifest.DataShards, set.Manifest.ParShards)
+	checkErr(err)
+
+	healer := reedsolomon.NewHealer(set, enc)
+	report, err := healer.Scan()
+	checkErr(err)
+
+	fmt.Printf("missing: %v\n", report.Missing)
+	fmt.Printf("corrupt: %v\n", report.Corrupt)
+	if report.Unrecoverable {
+		fmt.Println("WARNING: too few good shards remain to recover this set")
+		os.Exit(1)
+	}
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
+		os.Exit(2)
+	}
+}
@@ -2,47 +2,25 @@
 // +build ignore
 
 // Copyright 2015, Klaus Post, see LICENSE for details.
+// Copyright 2026, DurantVivado, see LICENSE for details.
 //
 // Simple encoder example
 //
-// The encoder encodes a simgle file into a number of shards
-// To reverse the process see "simpledecoder.go"
+// The encoder encodes a single file into a number of shards, using
+// reedsolomon.ShardWriter as a reusable library API instead of looping
+// over Split/Encode by hand. Alongside the shard files it writes a
+// manifest.json sidecar recording file size, shard counts, per-shard
+// hashes and placement, consumed by scrub.go, repair.go and rs-fuse.
 //
 // To build an executable use:
 //
-// go build simple-decoder.go
-//
-// Simple Encoder/Decoder Shortcomings:
-// * If the file size of the input isn't divisible by the number of data shards
-//   the output will contain extra zeroes
-//
-// * If the shard numbers isn't the same for the decoder as in the
-//   encoder, invalid output will be generated.
-//
-// * If values have changed in a shard, it cannot be reconstructed.
-//
-// * If two shards have been swapped, reconstruction will always fail.
-//   You need to supply the shards in the same order as they were given to you.
-//
-// The solution for this is to save a metadata file containing:
-//
-// * File size.
-// * The number of data/parity shards.
-// * HASH of each shard.
-// * Order of the shards.
-//
-// If you save these properties, you should abe able to detect file corruption
-// in a shard and be able to reconstruct your data if you have the needed number of shards left.
+// go build simple-encoder.go
 
 package main
 
 import (
-	"bufio"
-	"crypto/sha256"
 	"flag"
 	"fmt"
-	"io"
-	"math/rand"
 	"os"
 	"path/filepath"
 	"time"
@@ -64,15 +42,6 @@ func init() {
 	}
 }
 
-func genRandomArr(n int) []int {
-	shuff := make([]int, n)
-	for i := 0; i < n; i++ {
-		shuff[i] = i
-	}
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(shuff), func(i, j int) { shuff[i], shuff[j] = shuff[j], shuff[i] })
-	return shuff
-}
 func main() {
 	// Parse command line parameters.
 	flag.Parse()
@@ -88,77 +57,37 @@ func main() {
 	}
 	fname := args[0]
 	startTime := time.Now()
-	// Create encoding matrix.
+
 	enc, err := reedsolomon.New(*dataShards, *parShards)
 	checkErr(err)
 
 	f, err := os.Open(fname)
-	if err != nil {
-		panic(err)
-	}
+	checkErr(err)
 	defer f.Close()
-	fs, err := os.Stat(fname)
-	if err != nil {
-		panic(err)
-	}
-	fileSize := fs.Size()
-	h := sha256.New()
-	if _, err := io.Copy(h, f); err != nil {
-		panic(err)
-	}
-	hashStr := fmt.Sprintf("%x", h.Sum(nil))
-	f.Seek(0, 0)
-	fmt.Println("Opening", fname, ",hash", hashStr)
-	stripeno := int64(0)
-	stripeSize := int64(*dataShards) * (*blockSize)
-	data := make([]byte, stripeSize)
-	of := make([]*os.File, *dataShards+*parShards)
-	buf := bufio.NewReader(f)
-	stripeNum := (fileSize + stripeSize - 1) / stripeSize
-	distribution := make([][]int, stripeNum)
-	for {
-
-		b, err := buf.Read(data)
-		if err != nil && err != io.EOF {
-			panic(err)
-		}
-
-		// Split the file into equally sized shards.
-		shards, err := enc.Split(data)
-		checkErr(err)
-		fmt.Printf("stripe:%d, File split into %d data+parity shards with %d bytes/shard.\n", stripeno, len(shards), len(shards[0]))
-		// Encode parity
-		err = enc.Encode(shards)
-		checkErr(err)
-
-		// Write out the resulting files.
-		dir, file := filepath.Split(fname)
-		if *outDir != "" {
-			dir = *outDir
-		}
-		distribution[stripeno] = genRandomArr(*dataShards + *parShards)
-		for i := range shards {
-			j := distribution[stripeno][i]
-			outfn := fmt.Sprintf("%s.%d", file, i)
+	fi, err := f.Stat()
+	checkErr(err)
 
-			// fmt.Println("Writing to", outfn)
-			of[i], err = os.OpenFile(filepath.Join(dir, outfn), os.O_CREATE|os.O_APPEND, 0644)
-			checkErr(err)
-			of[i].Write(shards[j])
-			checkErr(err)
-		}
-		if int64(b) < stripeSize {
-			break
-		}
-		stripeno++
-	}
-	for i := range of {
-		of[i].Close()
+	dir, file := filepath.Split(fname)
+	if *outDir != "" {
+		dir = *outDir
 	}
-	//create a file and store the metainfo
 
-	fmt.Println("simple encoder time spent:", time.Now().Sub(startTime))
+	w, err := reedsolomon.NewShardWriter(enc, reedsolomon.ShardWriterOptions{
+		DataShards: *dataShards,
+		ParShards:  *parShards,
+		BlockSize:  *blockSize,
+		Dir:        dir,
+		Prefix:     file,
+	})
+	checkErr(err)
+
+	m, err := w.WriteFile(f, fi.Size())
+	checkErr(err)
+	checkErr(reedsolomon.WriteManifest(dir, m))
 
+	fmt.Printf("File split into %d data+parity shards across %d stripes, manifest written to %s\n",
+		*dataShards+*parShards, len(m.Placement), filepath.Join(dir, reedsolomon.ManifestName))
+	fmt.Println("simple encoder time spent:", time.Since(startTime))
 }
 
 func checkErr(err error) {
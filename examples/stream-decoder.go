@@ -0,0 +1,69 @@
+//go:build ignore
+// +build ignore
+
+// Copyright 2026, DurantVivado, see LICENSE for details.
+//
+// Stream decoder example
+//
+// Reads the manifest written by stream-encoder.go (or simple-encoder.go)
+// and decodes it back to stdout using reedsolomon.NewStreamDecoder as a
+// plain io.Reader, passing the manifest's placement so the decoder can
+// undo the per-stripe shuffle the encoder used.
+//
+// To build an executable use:
+//
+// go build stream-decoder.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/DurantVivado/reedsolomon"
+)
+
+var manifestPath = flag.String("manifest", "manifest.json", "Path to the ShardSet manifest")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  stream-decoder [-manifest manifest.json] >output\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	set, err := reedsolomon.OpenShardSet(*manifestPath)
+	checkErr(err)
+	m := set.Manifest
+
+	enc, err := reedsolomon.New(m.DataShards, m.ParShards)
+	checkErr(err)
+
+	readers := make([]io.Reader, m.DataShards+m.ParShards)
+	for _, si := range m.Shards {
+		f, err := os.Open(filepath.Join(set.Dir, si.Path))
+		checkErr(err)
+		defer f.Close()
+		readers[si.Index] = f
+	}
+
+	dec, err := reedsolomon.NewStreamDecoder(enc, m.DataShards, m.ParShards, m.BlockSize, readers, m.FileSize,
+		reedsolomon.WithPlacement(m.Placement),
+	)
+	checkErr(err)
+	defer dec.Close()
+
+	_, err = io.Copy(os.Stdout, dec)
+	checkErr(err)
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,95 @@
+//go:build ignore
+// +build ignore
+
+// Copyright 2026, DurantVivado, see LICENSE for details.
+//
+// Stream encoder example
+//
+// Unlike simple-encoder, which manually loops over stripes, this uses
+// reedsolomon.NewStreamEncoder as a plain io.Writer and prints a
+// progress line as stripes are encoded. It writes a manifest.json
+// alongside the shards, same as simple-encoder, so the result can be
+// read back with stream-decoder.go or opened with OpenShardSet.
+//
+// To build an executable use:
+//
+// go build stream-encoder.go
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/DurantVivado/reedsolomon"
+)
+
+var dataShards = flag.Int("data", 4, "Number of shards to split the data into, must be below 257.")
+var parShards = flag.Int("par", 2, "Number of parity shards")
+var blockSize = flag.Int64("bs", 1024, "block size")
+var outDir = flag.String("out", "", "Alternative output directory")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  stream-encoder [-flags] filename.ext\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "Error: No input filename given\n")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	enc, err := reedsolomon.New(*dataShards, *parShards)
+	checkErr(err)
+
+	f, err := os.Open(args[0])
+	checkErr(err)
+	defer f.Close()
+	fi, err := f.Stat()
+	checkErr(err)
+
+	dir := *outDir
+	if dir == "" {
+		dir, _ = os.Getwd()
+	}
+	file := fi.Name()
+	writers := make([]io.Writer, *dataShards+*parShards)
+	for i := range writers {
+		out, err := os.Create(fmt.Sprintf("%s/%s.%d", dir, file, i))
+		checkErr(err)
+		defer out.Close()
+		writers[i] = out
+	}
+
+	enc2, err := reedsolomon.NewStreamEncoder(enc, *dataShards, *parShards, *blockSize, writers,
+		reedsolomon.WithTotalSize(fi.Size()),
+		reedsolomon.WithProgress(func(done, total int64) {
+			fmt.Printf("\rencoded %d/%d bytes", done, total)
+		}),
+	)
+	checkErr(err)
+
+	_, err = io.Copy(enc2, f)
+	checkErr(err)
+	checkErr(enc2.Close())
+	fmt.Printf("\rencoded %d bytes, pad=%d\n", fi.Size(), enc2.PadLen())
+
+	m, err := enc2.Manifest()
+	checkErr(err)
+	checkErr(reedsolomon.WriteManifest(dir, m))
+	fmt.Printf("manifest written to %s\n", filepath.Join(dir, reedsolomon.ManifestName))
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2015, Klaus Post, see LICENSE for details.
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// HealReport summarizes the outcome of a Healer.Scan or Healer.Heal
+// pass over a ShardSet, using the shard files' physical slot indices
+// (ShardInfo.Index) throughout.
+type HealReport struct {
+	// Missing lists slots whose file could not be opened at all.
+	Missing []int
+	// Corrupt lists slots whose file exists but no longer matches the
+	// hash recorded in the manifest.
+	Corrupt []int
+	// Repaired lists shards that Heal successfully rewrote. Only set
+	// after Heal, never after Scan.
+	Repaired []int
+	// Unrecoverable is true when fewer than the set's DataShards shards
+	// verified good, so Heal refused to run.
+	Unrecoverable bool
+}
+
+// bad returns the union of Missing and Corrupt.
+func (r *HealReport) bad() []int {
+	bad := make([]int, 0, len(r.Missing)+len(r.Corrupt))
+	bad = append(bad, r.Missing...)
+	bad = append(bad, r.Corrupt...)
+	return bad
+}
+
+// Healer periodically scrubs a ShardSet, detecting and repairing
+// corrupted or missing shards in place. It rebuilds both data and
+// parity shards, not just data, so a ShardSet stays fully redundant
+// after a Heal.
+type Healer struct {
+	Set *ShardSet
+	Enc Encoder
+}
+
+// NewHealer returns a Healer that scrubs set using enc to reconstruct.
+// enc must have been created with set.Manifest.DataShards/ParShards.
+func NewHealer(set *ShardSet, enc Encoder) *Healer {
+	return &Healer{Set: set, Enc: enc}
+}
+
+// Scan recomputes each shard's hash from the manifest and classifies it
+// as missing, corrupt or good, without modifying anything on disk.
+func (h *Healer) Scan() (*HealReport, error) {
+	report := &HealReport{}
+	good := 0
+	for _, si := range h.Set.Manifest.Shards {
+		path := filepath.Join(h.Set.Dir, si.Path)
+		ok, err := verifyShardFile(path, si.SHA256)
+		switch {
+		case err != nil && os.IsNotExist(err):
+			report.Missing = append(report.Missing, si.Index)
+		case err != nil:
+			return nil, err
+		case !ok:
+			report.Corrupt = append(report.Corrupt, si.Index)
+		default:
+			good++
+		}
+	}
+	if good < h.Set.Manifest.DataShards {
+		report.Unrecoverable = true
+	}
+	return report, nil
+}
+
+// Heal runs Scan and, unless the set is Unrecoverable, reconstructs
+// every missing/corrupt slot from the surviving ones, stripe by
+// stripe, and rewrites the repaired files in place, updating the
+// manifest's hash and size for each. It refuses to touch anything when
+// fewer than DataShards slots verified good, since a reconstruction
+// from too few shards would itself be corrupt data.
+func (h *Healer) Heal() (*HealReport, error) {
+	report, err := h.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if report.Unrecoverable {
+		return report, fmt.Errorf("reedsolomon: heal: only %d of %d data shards verified good, need %d",
+			h.Set.Manifest.totalShards()-len(report.Missing)-len(report.Corrupt), h.Set.Manifest.totalShards(), h.Set.Manifest.DataShards)
+	}
+
+	bad := report.bad()
+	if len(bad) == 0 {
+		return report, nil
+	}
+
+	m := h.Set.Manifest
+	present := make([]bool, m.totalShards())
+	for i := range present {
+		present[i] = true
+	}
+	for _, slot := range bad {
+		present[slot] = false
+	}
+
+	// Placement re-shuffles which logical shard each slot holds every
+	// stripe, so a bad slot's repaired contents have to be rebuilt one
+	// stripe at a time and appended, the same way WriteFile built them.
+	repaired := make(map[int][]byte, len(bad))
+	for idx := range m.Placement {
+		shards, err := h.Set.stripeShards(idx, present)
+		if err != nil {
+			return nil, err
+		}
+		perm := m.Placement[idx]
+		required := make([]bool, len(shards))
+		for _, slot := range bad {
+			required[perm[slot]] = true
+		}
+		if err := h.Enc.ReconstructSome(shards, required); err != nil {
+			return nil, err
+		}
+		for _, slot := range bad {
+			repaired[slot] = append(repaired[slot], shards[perm[slot]]...)
+		}
+	}
+
+	for _, slot := range bad {
+		si, ok := h.shardInfo(slot)
+		if !ok {
+			return nil, fmt.Errorf("reedsolomon: heal: no manifest entry for slot %d", slot)
+		}
+		path := filepath.Join(h.Set.Dir, si.Path)
+		if err := writeFileAtomic(path, repaired[slot], 0o644); err != nil {
+			return nil, err
+		}
+		h.updateShardInfo(slot, repaired[slot])
+		report.Repaired = append(report.Repaired, slot)
+	}
+
+	return report, WriteManifest(h.Set.Dir, m)
+}
+
+// writeFileAtomic writes data to a ".tmp" sibling of path and renames
+// it into place, the same temp-file+rename pattern backend/local.go
+// uses for PutShard. A plain truncate-in-place write would let a
+// concurrent reader -- e.g. rs-fuse serving a Read while a heal runs in
+// the background -- observe a half-written, truncated-then-growing
+// file and silently decode garbage from it.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (h *Healer) shardInfo(idx int) (ShardInfo, bool) {
+	for _, si := range h.Set.Manifest.Shards {
+		if si.Index == idx {
+			return si, true
+		}
+	}
+	return ShardInfo{}, false
+}
+
+func (h *Healer) updateShardInfo(idx int, data []byte) {
+	for i, si := range h.Set.Manifest.Shards {
+		if si.Index != idx {
+			continue
+		}
+		h.Set.Manifest.Shards[i].Size = int64(len(data))
+		h.Set.Manifest.Shards[i].SHA256 = shardHash(data)
+		return
+	}
+}
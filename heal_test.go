@@ -0,0 +1,162 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestShardSet(t *testing.T, data []byte) (dir string, enc Encoder) {
+	t.Helper()
+	dir = t.TempDir()
+	var err error
+	enc, err = New(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewShardWriter(enc, ShardWriterOptions{DataShards: 4, ParShards: 2, BlockSize: 256, Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := w.WriteFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteManifest(dir, m); err != nil {
+		t.Fatal(err)
+	}
+	return dir, enc
+}
+
+// TestHealerRepairsMissingShard corrupts a data shard and a parity
+// shard by deleting each in turn, and checks Heal rewrites both back to
+// their original contents.
+func TestHealerRepairsMissingShard(t *testing.T) {
+	data := bytes.Repeat([]byte{0x5a}, 3000)
+	dir, enc := writeTestShardSet(t, data)
+
+	for _, slot := range []int{0, 5} { // a data shard and a parity shard
+		set, err := OpenShardSet(filepath.Join(dir, ManifestName))
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, set.Manifest.Shards[slot].Path)
+		want, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+
+		h := NewHealer(set, enc)
+		report, err := h.Heal()
+		if err != nil {
+			t.Fatalf("slot %d: Heal: %v", slot, err)
+		}
+		if len(report.Missing) != 1 || report.Missing[0] != slot {
+			t.Fatalf("slot %d: report.Missing = %v, want [%d]", slot, report.Missing, slot)
+		}
+		if len(report.Repaired) != 1 || report.Repaired[0] != slot {
+			t.Fatalf("slot %d: report.Repaired = %v, want [%d]", slot, report.Repaired, slot)
+		}
+
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("slot %d: repaired shard does not match original contents", slot)
+		}
+	}
+}
+
+// TestHealerRepairsCorruptShard overwrites a shard's file with wrong
+// bytes of the same length and checks Scan flags it as corrupt and Heal
+// restores its original contents.
+func TestHealerRepairsCorruptShard(t *testing.T) {
+	data := bytes.Repeat([]byte{0x11, 0x22, 0x33}, 1000)
+	dir, enc := writeTestShardSet(t, data)
+
+	set, err := OpenShardSet(filepath.Join(dir, ManifestName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const slot = 2
+	path := filepath.Join(dir, set.Manifest.Shards[slot].Path)
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	garbage := bytes.Repeat([]byte{0xff}, len(want))
+	if err := os.WriteFile(path, garbage, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHealer(set, enc)
+	scan, err := h.Scan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scan.Corrupt) != 1 || scan.Corrupt[0] != slot {
+		t.Fatalf("Scan.Corrupt = %v, want [%d]", scan.Corrupt, slot)
+	}
+
+	healReport, err := h.Heal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(healReport.Repaired) != 1 || healReport.Repaired[0] != slot {
+		t.Fatalf("Heal.Repaired = %v, want [%d]", healReport.Repaired, slot)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("repaired shard does not match original contents")
+	}
+
+	var decoded bytes.Buffer
+	if err := set.Decode(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decoded.Bytes(), data) {
+		t.Fatal("decoded file after heal does not match the original data")
+	}
+}
+
+// TestHealerRefusesWhenUnrecoverable deletes more shards than ParShards
+// can tolerate and checks Heal reports Unrecoverable and leaves the
+// surviving shards untouched rather than attempting a bad reconstruction.
+func TestHealerRefusesWhenUnrecoverable(t *testing.T) {
+	data := bytes.Repeat([]byte{0x9}, 2000)
+	dir, enc := writeTestShardSet(t, data)
+
+	set, err := OpenShardSet(filepath.Join(dir, ManifestName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range []int{0, 1, 2} { // only 2 parity shards available
+		path := filepath.Join(dir, set.Manifest.Shards[slot].Path)
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	h := NewHealer(set, enc)
+	report, err := h.Heal()
+	if err == nil {
+		t.Fatal("Heal: want error when unrecoverable, got nil")
+	}
+	if !report.Unrecoverable {
+		t.Fatal("report.Unrecoverable = false, want true")
+	}
+	if len(report.Repaired) != 0 {
+		t.Fatalf("report.Repaired = %v, want none when unrecoverable", report.Repaired)
+	}
+}
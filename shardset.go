@@ -0,0 +1,399 @@
+// Copyright 2015, Klaus Post, see LICENSE for details.
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ManifestVersion is the current on-disk version of Manifest. It is
+// bumped whenever the JSON layout changes in a backward-incompatible way.
+const ManifestVersion = 1
+
+// ManifestName is the default file name OpenShardSet and ShardWriter use
+// for the manifest sidecar.
+const ManifestName = "manifest.json"
+
+// ShardInfo describes a single shard file belonging to a ShardSet.
+type ShardInfo struct {
+	// Index is the physical slot (0..DataShards+ParShards-1) this file
+	// was written to, i.e. Path's own "shard.<N>" suffix. Because
+	// Manifest.Placement is re-shuffled per stripe, slot Index does not
+	// hold the same logical shard for every stripe -- Placement[stripe]
+	// says which logical shard each slot holds for that stripe.
+	Index int `json:"index"`
+	// Path is the shard's file name, relative to the manifest's own
+	// directory.
+	Path string `json:"path"`
+	// Size is the shard length in bytes.
+	Size int64 `json:"size"`
+	// SHA256 is the hex-encoded SHA-256 checksum of the shard contents.
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest is the JSON sidecar written alongside a set of shards. It
+// records everything simple-encoder's doc comment lists as missing: the
+// original file size, shard counts, block size, per-shard hashes and the
+// placement permutation used when the shards were written out, so a
+// ShardSet can later detect corruption and reconstruct in the right
+// order.
+type Manifest struct {
+	Version    int   `json:"version"`
+	FileSize   int64 `json:"fileSize"`
+	DataShards int   `json:"dataShards"`
+	ParShards  int   `json:"parShards"`
+	BlockSize  int64 `json:"blockSize"`
+	// PadLen is the number of zero bytes the final stripe was padded
+	// with, so a reader can tell real data from padding without
+	// guessing; Decode derives the same information from FileSize, but
+	// PadLen lets other tools do so without re-deriving stripe math.
+	PadLen int64 `json:"padLen"`
+	// Placement[stripe][slot] holds the shard index that was written to
+	// slot `slot` of stripe `stripe`.
+	Placement [][]int     `json:"placement"`
+	Shards    []ShardInfo `json:"shards"`
+}
+
+// totalShards returns DataShards+ParShards.
+func (m *Manifest) totalShards() int {
+	return m.DataShards + m.ParShards
+}
+
+// ShardWriterOptions configures NewShardWriter.
+type ShardWriterOptions struct {
+	// DataShards and ParShards must match the Encoder passed to
+	// NewShardWriter.
+	DataShards int
+	ParShards  int
+	// BlockSize is the per-shard size of one stripe. Defaults to 1<<20
+	// (1MiB) when zero.
+	BlockSize int64
+	// Dir is the directory shards and the manifest are written to.
+	// Defaults to the current directory.
+	Dir string
+	// Prefix is prepended to shard file names as "<prefix>.<index>".
+	// Defaults to "shard".
+	Prefix string
+}
+
+func (o *ShardWriterOptions) setDefaults() {
+	if o.BlockSize <= 0 {
+		o.BlockSize = 1 << 20
+	}
+	if o.Prefix == "" {
+		o.Prefix = "shard"
+	}
+}
+
+// ShardWriter streams an input file through Split/Encode and writes the
+// resulting data+parity shards to disk, one file per shard slot, plus a
+// Manifest describing the layout. It replaces the ad-hoc loop in
+// simple-encoder with a reusable API.
+type ShardWriter struct {
+	enc  Encoder
+	opts ShardWriterOptions
+}
+
+// NewShardWriter returns a ShardWriter that encodes through enc using
+// opts. enc must have been created with opts.DataShards/opts.ParShards.
+func NewShardWriter(enc Encoder, opts ShardWriterOptions) (*ShardWriter, error) {
+	if enc == nil {
+		return nil, errors.New("reedsolomon: NewShardWriter: nil Encoder")
+	}
+	if opts.DataShards <= 0 || opts.ParShards < 0 {
+		return nil, errors.New("reedsolomon: NewShardWriter: invalid shard counts")
+	}
+	opts.setDefaults()
+	if opts.Dir != "" {
+		if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &ShardWriter{enc: enc, opts: opts}, nil
+}
+
+// WriteFile reads all of src, splitting and encoding it stripe by
+// stripe, and writes one file per shard slot under opts.Dir. The final
+// short stripe is zero-padded like Split always does; the pad length is
+// implied by fileSize and is recomputed by the reader, so no "extra
+// zeroes" leak into the decoded output.
+//
+// It returns the Manifest describing the shards, which the caller must
+// persist with WriteManifest (or similar) before the shards are useful
+// on their own.
+func (w *ShardWriter) WriteFile(src io.Reader, fileSize int64) (*Manifest, error) {
+	total := w.opts.DataShards + w.opts.ParShards
+	stripeSize := int64(w.opts.DataShards) * w.opts.BlockSize
+
+	files := make([]*os.File, total)
+	hashes := make([]hash.Hash, total)
+	for i := range files {
+		name := filepath.Join(w.opts.Dir, fmt.Sprintf("%s.%d", w.opts.Prefix, i))
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+		if err != nil {
+			closeAll(files)
+			return nil, err
+		}
+		files[i] = f
+		hashes[i] = sha256.New()
+	}
+
+	m := &Manifest{
+		Version:    ManifestVersion,
+		FileSize:   fileSize,
+		DataShards: w.opts.DataShards,
+		ParShards:  w.opts.ParShards,
+		BlockSize:  w.opts.BlockSize,
+	}
+
+	buf := make([]byte, stripeSize)
+	for {
+		n, err := io.ReadFull(src, buf)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			closeAll(files)
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		shards, padLen, encErr := splitEncodeStripe(w.enc, buf, n)
+		if encErr != nil {
+			closeAll(files)
+			return nil, encErr
+		}
+		m.PadLen = padLen
+
+		perm := genRandomArr(total)
+		m.Placement = append(m.Placement, perm)
+		for slot, idx := range perm {
+			if _, err := files[slot].Write(shards[idx]); err != nil {
+				closeAll(files)
+				return nil, err
+			}
+			hashes[slot].Write(shards[idx])
+		}
+
+		if err == io.EOF || int64(n) < stripeSize {
+			break
+		}
+	}
+
+	for i, f := range files {
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		m.Shards = append(m.Shards, ShardInfo{
+			Index:  i,
+			Path:   filepath.Base(f.Name()),
+			Size:   info.Size(),
+			SHA256: hex.EncodeToString(hashes[i].Sum(nil)),
+		})
+		if err := f.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// WriteManifest marshals m as indented JSON to <dir>/ManifestName.
+func WriteManifest(dir string, m *Manifest) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, ManifestName), b, 0o644)
+}
+
+// ShardSet is a manifest plus the directory its shards live in. It is
+// produced by OpenShardSet and consumed by Reconstruct/Healer.
+type ShardSet struct {
+	Dir      string
+	Manifest *Manifest
+}
+
+// OpenShardSet reads and parses the manifest at manifestPath. It does
+// not touch the shard files themselves; call Verify to check them.
+func OpenShardSet(manifestPath string) (*ShardSet, error) {
+	b, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("reedsolomon: parsing manifest: %w", err)
+	}
+	if m.Version != ManifestVersion {
+		return nil, fmt.Errorf("reedsolomon: unsupported manifest version %d", m.Version)
+	}
+	return &ShardSet{Dir: filepath.Dir(manifestPath), Manifest: &m}, nil
+}
+
+// Verify re-hashes every shard on disk and reports which ones are
+// missing or corrupt. present[i] is true when shard i exists on disk
+// and matches its recorded hash.
+func (s *ShardSet) Verify() (present []bool, err error) {
+	total := s.Manifest.totalShards()
+	present = make([]bool, total)
+	for _, si := range s.Manifest.Shards {
+		ok, verr := verifyShardFile(filepath.Join(s.Dir, si.Path), si.SHA256)
+		if verr != nil && !os.IsNotExist(verr) {
+			return present, verr
+		}
+		present[si.Index] = ok
+	}
+	return present, nil
+}
+
+// stripeShards reads the slice of shards (indexed by logical shard
+// index, not physical slot) available for stripe idx, leaving entries
+// nil where present says the slot holding that stripe's data is
+// missing or corrupt.
+func (s *ShardSet) stripeShards(idx int, present []bool) ([][]byte, error) {
+	m := s.Manifest
+	perm := m.Placement[idx]
+	shards := make([][]byte, m.totalShards())
+	for slot, shardIdx := range perm {
+		if !present[slot] {
+			continue
+		}
+		b, err := readShardRange(filepath.Join(s.Dir, m.Shards[slot].Path), int64(idx)*m.BlockSize, m.BlockSize)
+		if err != nil {
+			return nil, err
+		}
+		shards[shardIdx] = b
+	}
+	return shards, nil
+}
+
+// Decode reconstructs any missing/corrupt shards using enc, stripe by
+// stripe, and writes the original file to dst.
+func (s *ShardSet) Decode(enc Encoder, dst io.Writer) error {
+	present, err := s.Verify()
+	if err != nil {
+		return err
+	}
+	good := 0
+	for _, ok := range present {
+		if ok {
+			good++
+		}
+	}
+	if good < s.Manifest.DataShards {
+		return fmt.Errorf("reedsolomon: only %d of %d data shards available, need %d", good, s.Manifest.totalShards(), s.Manifest.DataShards)
+	}
+
+	for idx := range s.Manifest.Placement {
+		stripe, err := s.ReadStripe(enc, idx, present)
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(stripe); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadStripe reconstructs stripe idx using whichever slots present
+// marks available, and returns its decoded bytes -- outSize bytes for
+// every stripe but the last, which is trimmed to whatever is left of
+// Manifest.FileSize. It is the single-stripe primitive Decode uses
+// internally, exported so callers that only need part of a file (e.g.
+// rs-fuse serving one Read request) don't have to duplicate the
+// offset math and reconstruct/join flow themselves.
+func (s *ShardSet) ReadStripe(enc Encoder, idx int, present []bool) ([]byte, error) {
+	shards, err := s.stripeShards(idx, present)
+	if err != nil {
+		return nil, err
+	}
+	if err := enc.ReconstructData(shards); err != nil {
+		return nil, err
+	}
+
+	m := s.Manifest
+	stripeSize := int64(m.DataShards) * m.BlockSize
+	outSize := stripeSize
+	if idx == len(m.Placement)-1 {
+		outSize = m.FileSize - int64(idx)*stripeSize
+	}
+	var buf bytes.Buffer
+	if err := enc.Join(&buf, shards, int(outSize)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// readShardRange reads length bytes at offset from the shard file at
+// path, used to pull just one stripe's worth of data out of a slot
+// file that otherwise holds every stripe concatenated.
+func readShardRange(path string, offset, length int64) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	buf := make([]byte, length)
+	n, err := f.ReadAt(buf, offset)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return buf[:n], nil
+}
+
+func verifyShardFile(path, wantHash string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)) == wantHash, nil
+}
+
+// shardHash returns the hex-encoded SHA-256 of a shard's contents, for
+// recording in a Manifest after a repair.
+func shardHash(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func closeAll(files []*os.File) {
+	for _, f := range files {
+		if f != nil {
+			f.Close()
+		}
+	}
+}
+
+// genRandomArr returns a random permutation of 0..n-1, used to decide
+// which physical slot each logical shard index is written to for a
+// stripe, mirroring simple-encoder's shuffle.
+func genRandomArr(n int) []int {
+	shuff := make([]int, n)
+	for i := range shuff {
+		shuff[i] = i
+	}
+	rand.New(rand.NewSource(time.Now().UnixNano())).Shuffle(len(shuff), func(i, j int) {
+		shuff[i], shuff[j] = shuff[j], shuff[i]
+	})
+	return shuff
+}
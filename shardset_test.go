@@ -0,0 +1,113 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestShardWriterRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	enc, err := New(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]byte, 5000)
+	for i := range want {
+		want[i] = byte(i)
+	}
+
+	w, err := NewShardWriter(enc, ShardWriterOptions{
+		DataShards: 4,
+		ParShards:  2,
+		BlockSize:  256,
+		Dir:        dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := w.WriteFile(bytes.NewReader(want), int64(len(want)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteManifest(dir, m); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := OpenShardSet(filepath.Join(dir, ManifestName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	present, err := set.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, ok := range present {
+		if !ok {
+			t.Fatalf("shard %d: Verify reported missing/corrupt on an untouched set", i)
+		}
+	}
+
+	var got bytes.Buffer
+	if err := set.Decode(enc, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Fatalf("decoded %d bytes, want %d bytes matching the original", got.Len(), len(want))
+	}
+}
+
+func TestShardSetVerifyDetectsCorruption(t *testing.T) {
+	dir := t.TempDir()
+	enc, err := New(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewShardWriter(enc, ShardWriterOptions{DataShards: 4, ParShards: 2, BlockSize: 256, Dir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := bytes.Repeat([]byte{0x42}, 3000)
+	m, err := w.WriteFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteManifest(dir, m); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := OpenShardSet(filepath.Join(dir, ManifestName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	corrupt := filepath.Join(dir, m.Shards[0].Path)
+	if err := os.WriteFile(corrupt, []byte("not the right bytes at all"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	present, err := set.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if present[0] {
+		t.Fatal("Verify did not detect a corrupted shard")
+	}
+
+	missing := filepath.Join(dir, m.Shards[1].Path)
+	if err := os.Remove(missing); err != nil {
+		t.Fatal(err)
+	}
+	present, err = set.Verify()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if present[1] {
+		t.Fatal("Verify did not detect a missing shard")
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright 2015, Klaus Post, see LICENSE for details.
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// EncodeProgress is called as a StreamEncoder or StreamDecoder makes
+// progress on a large file, with the number of bytes processed so far
+// and the total expected (0 if unknown), so a CLI can render a
+// progress bar during encode/decode/heal.
+type EncodeProgress func(bytesDone, bytesTotal int64)
+
+// StreamOption configures NewStreamEncoder/NewStreamDecoder.
+type StreamOption func(*streamOptions)
+
+type streamOptions struct {
+	totalSize int64
+	progress  EncodeProgress
+	placement [][]int
+}
+
+// WithTotalSize tells a StreamEncoder the final input size up front,
+// purely so it can report accurate progress; encoding itself does not
+// need to know the size ahead of time.
+func WithTotalSize(size int64) StreamOption {
+	return func(o *streamOptions) { o.totalSize = size }
+}
+
+// WithProgress registers cb to be called after every stripe is encoded
+// or decoded.
+func WithProgress(cb EncodeProgress) StreamOption {
+	return func(o *streamOptions) { o.progress = cb }
+}
+
+// WithPlacement tells a StreamDecoder the per-stripe shard placement a
+// StreamEncoder recorded in its Manifest, so it can undo the same
+// shuffle ShardSet.ReadStripe does before reconstructing. Omit it only
+// when shardReaders are already in logical shard order, e.g. reading
+// back a stream that was never shuffled in the first place.
+func WithPlacement(placement [][]int) StreamOption {
+	return func(o *streamOptions) { o.placement = placement }
+}
+
+// StreamEncoder is an io.WriteCloser that buffers writes into
+// dataShards*blockSize stripes, Splits/Encodes each full stripe as it
+// fills and writes the resulting shards to shardWriters, one writer per
+// shard slot. It replaces the manual bufio.Read/Split/Encode loop in
+// simple-encoder with a plain io.Writer, so callers can just io.Copy
+// into it.
+//
+// Like ShardWriter, it re-shuffles which logical shard goes to which
+// slot every stripe and hashes each slot's contents as it writes, so
+// that when shardWriters are *os.File, Manifest (called after Close)
+// returns a Manifest that OpenShardSet, Healer and rs-fuse can all work
+// with directly.
+type StreamEncoder struct {
+	enc        Encoder
+	dataShards int
+	parShards  int
+	writers    []io.Writer
+	opts       streamOptions
+
+	buf       []byte
+	filled    int
+	bytesDone int64
+	padLen    int64
+	closed    bool
+
+	placement [][]int
+	hashes    []hash.Hash
+	sizes     []int64
+}
+
+// NewStreamEncoder returns a StreamEncoder that splits/encodes through
+// enc with the given dataShards/parShards and blockSize, writing shard
+// i of every stripe to shardWriters[i]. len(shardWriters) must equal
+// dataShards+parShards.
+func NewStreamEncoder(enc Encoder, dataShards, parShards int, blockSize int64, shardWriters []io.Writer, opts ...StreamOption) (*StreamEncoder, error) {
+	if enc == nil {
+		return nil, errors.New("reedsolomon: NewStreamEncoder: nil Encoder")
+	}
+	if dataShards <= 0 || blockSize <= 0 {
+		return nil, errors.New("reedsolomon: NewStreamEncoder: invalid dataShards/blockSize")
+	}
+	if len(shardWriters) != dataShards+parShards {
+		return nil, fmt.Errorf("reedsolomon: NewStreamEncoder: got %d shard writers, want %d", len(shardWriters), dataShards+parShards)
+	}
+	hashes := make([]hash.Hash, len(shardWriters))
+	for i := range hashes {
+		hashes[i] = sha256.New()
+	}
+	e := &StreamEncoder{
+		enc:        enc,
+		dataShards: dataShards,
+		parShards:  parShards,
+		writers:    shardWriters,
+		buf:        make([]byte, int64(dataShards)*blockSize),
+		hashes:     hashes,
+		sizes:      make([]int64, len(shardWriters)),
+	}
+	for _, o := range opts {
+		o(&e.opts)
+	}
+	return e, nil
+}
+
+// PadLen returns the number of zero bytes the final stripe was padded
+// with. It is only meaningful after Close and should be recorded in the
+// Manifest alongside FileSize so a decoder can tell real data from
+// padding without guessing.
+func (e *StreamEncoder) PadLen() int64 { return e.padLen }
+
+// Write implements io.Writer, buffering p until a full stripe has
+// accumulated, at which point it is split, encoded and flushed.
+func (e *StreamEncoder) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("reedsolomon: StreamEncoder: write after Close")
+	}
+	total := 0
+	for len(p) > 0 {
+		n := copy(e.buf[e.filled:], p)
+		e.filled += n
+		p = p[n:]
+		total += n
+		if e.filled == len(e.buf) {
+			if err := e.flushStripe(len(e.buf)); err != nil {
+				return total, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// flushStripe splits/encodes e.buf[:n], zero-padding up to a full
+// stripe first if n is short, and writes the resulting shards out,
+// permuted the same way ShardWriter.WriteFile does so the output stays
+// Manifest-compatible.
+func (e *StreamEncoder) flushStripe(n int) error {
+	shards, padLen, err := splitEncodeStripe(e.enc, e.buf, n)
+	if err != nil {
+		return err
+	}
+	e.padLen = padLen
+
+	perm := genRandomArr(len(e.writers))
+	e.placement = append(e.placement, perm)
+	for slot, idx := range perm {
+		if _, err := e.writers[slot].Write(shards[idx]); err != nil {
+			return err
+		}
+		e.hashes[slot].Write(shards[idx])
+		e.sizes[slot] += int64(len(shards[idx]))
+	}
+	e.bytesDone += int64(n)
+	if e.opts.progress != nil {
+		e.opts.progress(e.bytesDone, e.opts.totalSize)
+	}
+	e.filled = 0
+	return nil
+}
+
+// Close flushes any buffered partial stripe (zero-padded, see PadLen)
+// and closes every shardWriter that implements io.Closer.
+func (e *StreamEncoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	if e.filled > 0 {
+		if err := e.flushStripe(e.filled); err != nil {
+			return err
+		}
+	}
+	for _, w := range e.writers {
+		if c, ok := w.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Manifest returns a Manifest describing every stripe written so far,
+// in the same shape ShardWriter.WriteFile produces. It requires every
+// shardWriter passed to NewStreamEncoder to be an *os.File, since
+// ShardInfo.Path records each shard's file name; call it after Close so
+// Shards[].Size reflects the final on-disk size.
+func (e *StreamEncoder) Manifest() (*Manifest, error) {
+	m := &Manifest{
+		Version:    ManifestVersion,
+		FileSize:   e.bytesDone,
+		DataShards: e.dataShards,
+		ParShards:  e.parShards,
+		BlockSize:  int64(len(e.buf)) / int64(e.dataShards),
+		PadLen:     e.padLen,
+		Placement:  e.placement,
+	}
+	for i, w := range e.writers {
+		f, ok := w.(*os.File)
+		if !ok {
+			return nil, fmt.Errorf("reedsolomon: StreamEncoder: Manifest needs file-backed shard writers, writer %d is %T", i, w)
+		}
+		m.Shards = append(m.Shards, ShardInfo{
+			Index:  i,
+			Path:   filepath.Base(f.Name()),
+			Size:   e.sizes[i],
+			SHA256: hex.EncodeToString(e.hashes[i].Sum(nil)),
+		})
+	}
+	return m, nil
+}
+
+var _ io.WriteCloser = (*StreamEncoder)(nil)
+
+// StreamDecoder is an io.ReadCloser that pulls dataShards+parShards
+// worth of shards from shardReaders one stripe at a time, reconstructs
+// and joins each stripe, and serves the decoded bytes to Read, trimming
+// the padding off the final stripe using fileSize so no "extra zeroes"
+// leak into the output.
+//
+// A reader may be nil to mark that shard as unavailable up front (e.g.
+// a node known to be down); StreamDecoder reconstructs around it the
+// same way it would a read error.
+type StreamDecoder struct {
+	enc        Encoder
+	dataShards int
+	parShards  int
+	blockSize  int64
+	readers    []io.Reader
+	fileSize   int64
+	opts       streamOptions
+
+	pending   []byte
+	bytesDone int64
+	stripe    int
+	done      bool
+}
+
+// NewStreamDecoder returns a StreamDecoder that reconstructs through
+// enc with the given dataShards/parShards and blockSize, reading shard
+// i of every stripe from shardReaders[i]. fileSize is the original
+// (unpadded) file size, as recorded in the Manifest.
+func NewStreamDecoder(enc Encoder, dataShards, parShards int, blockSize int64, shardReaders []io.Reader, fileSize int64, opts ...StreamOption) (*StreamDecoder, error) {
+	if enc == nil {
+		return nil, errors.New("reedsolomon: NewStreamDecoder: nil Encoder")
+	}
+	if dataShards <= 0 || blockSize <= 0 {
+		return nil, errors.New("reedsolomon: NewStreamDecoder: invalid dataShards/blockSize")
+	}
+	if len(shardReaders) != dataShards+parShards {
+		return nil, fmt.Errorf("reedsolomon: NewStreamDecoder: got %d shard readers, want %d", len(shardReaders), dataShards+parShards)
+	}
+	d := &StreamDecoder{
+		enc:        enc,
+		dataShards: dataShards,
+		parShards:  parShards,
+		blockSize:  blockSize,
+		readers:    shardReaders,
+		fileSize:   fileSize,
+	}
+	for _, o := range opts {
+		o(&d.opts)
+	}
+	return d, nil
+}
+
+// Read implements io.Reader.
+func (d *StreamDecoder) Read(p []byte) (int, error) {
+	if len(d.pending) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.nextStripe(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.pending)
+	d.pending = d.pending[n:]
+	return n, nil
+}
+
+// nextStripe reads one stripe's worth of shards, reconstructs and joins
+// it into d.pending, trimming to what's left of fileSize.
+func (d *StreamDecoder) nextStripe() error {
+	remaining := d.fileSize - d.bytesDone
+	if remaining <= 0 {
+		d.done = true
+		return io.EOF
+	}
+
+	var perm []int
+	if d.opts.placement != nil {
+		perm = d.opts.placement[d.stripe]
+	}
+	shards := make([][]byte, d.dataShards+d.parShards)
+	for slot, r := range d.readers {
+		if r == nil {
+			continue
+		}
+		b := make([]byte, d.blockSize)
+		n, err := io.ReadFull(r, b)
+		if err != nil {
+			continue // treat any read error, including a short/truncated shard, as missing -- not as intact data
+		}
+		if n == 0 {
+			continue
+		}
+		shardIdx := slot
+		if perm != nil {
+			shardIdx = perm[slot]
+		}
+		shards[shardIdx] = b
+	}
+	d.stripe++
+
+	if err := d.enc.ReconstructData(shards); err != nil {
+		return err
+	}
+
+	stripeSize := int64(d.dataShards) * d.blockSize
+	outSize := stripeSize
+	if remaining < stripeSize {
+		outSize = remaining
+	}
+
+	var buf bytes.Buffer
+	if err := d.enc.Join(&buf, shards, int(outSize)); err != nil {
+		return err
+	}
+	d.pending = buf.Bytes()
+	d.bytesDone += outSize
+	if d.opts.progress != nil {
+		d.opts.progress(d.bytesDone, d.fileSize)
+	}
+	if d.bytesDone >= d.fileSize {
+		d.done = true
+	}
+	return nil
+}
+
+// Close closes every shardReader that implements io.Closer.
+func (d *StreamDecoder) Close() error {
+	for _, r := range d.readers {
+		if c, ok := r.(io.Closer); ok {
+			if err := c.Close(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var _ io.ReadCloser = (*StreamDecoder)(nil)
@@ -0,0 +1,80 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStreamEncoderDecoderRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	enc, err := New(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := make([]byte, 5000)
+	for i := range want {
+		want[i] = byte(i * 7)
+	}
+
+	files := make([]*os.File, 6)
+	writers := make([]io.Writer, 6)
+	for i := range files {
+		f, err := os.Create(filepath.Join(dir, "shard."+string(rune('0'+i))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		files[i] = f
+		writers[i] = f
+	}
+
+	se, err := NewStreamEncoder(enc, 4, 2, 256, writers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := io.Copy(se, bytes.NewReader(want)); err != nil {
+		t.Fatal(err)
+	}
+	if err := se.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := se.Manifest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.FileSize != int64(len(want)) {
+		t.Fatalf("Manifest FileSize = %d, want %d", m.FileSize, len(want))
+	}
+	if len(m.Shards) != 6 {
+		t.Fatalf("Manifest has %d shards, want 6", len(m.Shards))
+	}
+
+	readers := make([]io.Reader, 6)
+	for i := range readers {
+		f, err := os.Open(filepath.Join(dir, m.Shards[i].Path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		readers[i] = f
+	}
+
+	sd, err := NewStreamDecoder(enc, 4, 2, 256, readers, m.FileSize, WithPlacement(m.Placement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got bytes.Buffer
+	if _, err := io.Copy(&got, sd); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Fatalf("decoded %d bytes, want %d bytes matching the original", got.Len(), len(want))
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2026, DurantVivado, see LICENSE for details.
+
+package reedsolomon
+
+// splitEncodeStripe zero-pads buf[n:] when n is short of a full stripe,
+// then Splits/Encodes buf through enc, returning the resulting shards
+// and how many bytes of buf were padding. It is the one place
+// ShardWriter.WriteFile, DistributedWriter.WriteFile and
+// StreamEncoder.flushStripe turn one stripe's raw bytes into shards, so
+// the short-stripe padding and split/encode framing isn't copied a
+// third time between them.
+func splitEncodeStripe(enc Encoder, buf []byte, n int) (shards [][]byte, padLen int64, err error) {
+	if n < len(buf) {
+		padLen = int64(len(buf) - n)
+		for i := n; i < len(buf); i++ {
+			buf[i] = 0
+		}
+	}
+	shards, err = enc.Split(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err = enc.Encode(shards); err != nil {
+		return nil, 0, err
+	}
+	return shards, padLen, nil
+}